@@ -0,0 +1,18 @@
+//Package notify описывает доставку токена восстановления пароля покупателю,
+//не привязывая customers.Service к конкретному транспорту (SMS, email, ...).
+package notify
+
+//Notifier доставляет токен восстановления пароля покупателю по его
+//контакту (номеру телефона или email - в зависимости от реализации).
+type Notifier interface {
+	SendReset(phone, token string) error
+}
+
+//NoopNotifier ничего не отправляет. Используется в тестах; с ним токен,
+//выпущенный RequestPasswordReset, никуда не доставляется.
+type NoopNotifier struct{}
+
+//SendReset ...
+func (NoopNotifier) SendReset(phone, token string) error {
+	return nil
+}
@@ -0,0 +1,37 @@
+package notify
+
+import (
+	"fmt"
+	"net/smtp"
+	"strings"
+)
+
+//SMTPNotifier отправляет токен восстановления пароля письмом через SMTP.
+type SMTPNotifier struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+//NewSMTPNotifier создаёт SMTPNotifier. addr - адрес SMTP-сервера ("host:port"),
+//from - адрес отправителя, auth - аутентификация на сервере (может быть nil).
+func NewSMTPNotifier(addr, from string, auth smtp.Auth) *SMTPNotifier {
+	return &SMTPNotifier{addr: addr, from: from, auth: auth}
+}
+
+//SendReset отправляет письмо с токеном восстановления на адрес phone
+//(используется как email получателя). phone нигде не проверяется на формат
+//выше по стеку, поэтому здесь отклоняются значения с CR/LF - иначе они
+//попадут прямо в заголовки письма (SMTP header injection).
+func (n *SMTPNotifier) SendReset(phone, token string) error {
+	if strings.ContainsAny(phone, "\r\n") {
+		return fmt.Errorf("notify: invalid recipient %q", phone)
+	}
+
+	to := []string{phone}
+	msg := []byte(fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: Восстановление пароля\r\n\r\nВаш код для восстановления пароля: %s\r\n",
+		n.from, phone, token))
+
+	return smtp.SendMail(n.addr, n.auth, n.from, to, msg)
+}
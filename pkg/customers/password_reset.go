@@ -0,0 +1,87 @@
+package customers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+//RequestPasswordReset заводит одноразовый токен восстановления пароля для
+//покупателя с номером phone и отправляет его через notifier. Сам токен
+//нигде не хранится - только его SHA-256-хэш, чтобы утечка базы не позволяла
+//восстановить пароль произвольного покупателя.
+func (s *Service) RequestPasswordReset(ctx context.Context, phone string) error {
+	id, _, err := s.storage.PasswordByPhone(ctx, phone)
+	if errors.Is(err, ErrNoSuchUser) {
+		return ErrNoSuchUser
+	}
+	if err != nil {
+		return ErrInternal
+	}
+
+	buffer := make([]byte, 32)
+	if n, err := rand.Read(buffer); n != len(buffer) || err != nil {
+		return ErrInternal
+	}
+	token := hex.EncodeToString(buffer)
+
+	if err := s.storage.CreatePasswordReset(ctx, id, hashResetToken(token), time.Now().Add(PasswordResetTTL)); err != nil {
+		return ErrInternal
+	}
+
+	if err := s.notifier.SendReset(phone, token); err != nil {
+		return ErrInternal
+	}
+
+	return nil
+}
+
+//ResetPassword проверяет resetToken и, если он существует, не истёк и ещё
+//не был использован, устанавливает покупателю пароль newPassword.
+func (s *Service) ResetPassword(ctx context.Context, resetToken, newPassword string) error {
+	hash := hashResetToken(resetToken)
+
+	customerID, expiresAt, used, err := s.storage.PasswordResetByHash(ctx, hash)
+	if errors.Is(err, ErrNotFound) {
+		return ErrInvalidResetToken
+	}
+	if err != nil {
+		return ErrInternal
+	}
+	if used || time.Now().After(expiresAt) {
+		return ErrInvalidResetToken
+	}
+
+	//MarkPasswordResetUsed - единственная атомарная операция (WHERE used_at
+	//IS NULL), так что именно она, а не проверка выше, гейтит одноразовость:
+	//из двух конкурентных вызовов с одним токеном только один получит
+	//ok == true и только он применит новый пароль.
+	ok, err := s.storage.MarkPasswordResetUsed(ctx, hash)
+	if err != nil {
+		return ErrInternal
+	}
+	if !ok {
+		return ErrInvalidResetToken
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return ErrInternal
+	}
+
+	if err := s.storage.UpdatePassword(ctx, customerID, string(passwordHash)); err != nil {
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,98 @@
+package customers
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+//resetRaceStorage - минимальная in-memory реализация Storage, где
+//MarkPasswordResetUsed атомарно отдаёт ok == true ровно одному вызывающему,
+//как это делает реальный UPDATE ... WHERE used_at IS NULL.
+type resetRaceStorage struct {
+	Storage
+
+	customerID int64
+	expiresAt  time.Time
+
+	used          int32
+	updateCalls   int32
+	updatedHashes []string
+	mu            sync.Mutex
+}
+
+func (s *resetRaceStorage) PasswordResetByHash(ctx context.Context, tokenHash string) (int64, time.Time, bool, error) {
+	return s.customerID, s.expiresAt, atomic.LoadInt32(&s.used) != 0, nil
+}
+
+func (s *resetRaceStorage) MarkPasswordResetUsed(ctx context.Context, tokenHash string) (bool, error) {
+	return atomic.CompareAndSwapInt32(&s.used, 0, 1), nil
+}
+
+func (s *resetRaceStorage) UpdatePassword(ctx context.Context, customerID int64, passwordHash string) error {
+	atomic.AddInt32(&s.updateCalls, 1)
+	s.mu.Lock()
+	s.updatedHashes = append(s.updatedHashes, passwordHash)
+	s.mu.Unlock()
+	return nil
+}
+
+func TestResetPassword_ConcurrentCallsApplyOnlyOnce(t *testing.T) {
+	storage := &resetRaceStorage{customerID: 1, expiresAt: time.Now().Add(time.Hour)}
+	svc := &Service{storage: storage}
+
+	const attempts = 20
+	var wg sync.WaitGroup
+	results := make([]error, attempts)
+
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = svc.ResetPassword(context.Background(), "the-token", "new-password")
+		}(i)
+	}
+	wg.Wait()
+
+	var succeeded int
+	for _, err := range results {
+		if err == nil {
+			succeeded++
+		} else if err != ErrInvalidResetToken {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if succeeded != 1 {
+		t.Fatalf("got %d successful ResetPassword calls out of %d, want exactly 1", succeeded, attempts)
+	}
+	if storage.updateCalls != 1 {
+		t.Fatalf("UpdatePassword called %d times, want exactly 1", storage.updateCalls)
+	}
+}
+
+func TestResetPassword_AlreadyUsed(t *testing.T) {
+	storage := &resetRaceStorage{customerID: 1, expiresAt: time.Now().Add(time.Hour), used: 1}
+	svc := &Service{storage: storage}
+
+	if err := svc.ResetPassword(context.Background(), "the-token", "new-password"); err != ErrInvalidResetToken {
+		t.Fatalf("ResetPassword err = %v, want ErrInvalidResetToken", err)
+	}
+	if storage.updateCalls != 0 {
+		t.Fatal("UpdatePassword must not be called for an already-used token")
+	}
+}
+
+func TestResetPassword_Expired(t *testing.T) {
+	storage := &resetRaceStorage{customerID: 1, expiresAt: time.Now().Add(-time.Minute)}
+	svc := &Service{storage: storage}
+
+	if err := svc.ResetPassword(context.Background(), "the-token", "new-password"); err != ErrInvalidResetToken {
+		t.Fatalf("ResetPassword err = %v, want ErrInvalidResetToken", err)
+	}
+	if storage.updateCalls != 0 {
+		t.Fatal("UpdatePassword must not be called for an expired token")
+	}
+}
@@ -0,0 +1,80 @@
+package customers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/urunov-fedya/crud/pkg/customers/rbac"
+	"github.com/urunov-fedya/crud/pkg/customers/sessions"
+)
+
+//HasPermission сообщает, обладает ли менеджер managerID разрешением perm.
+func (s *Service) HasPermission(ctx context.Context, managerID int64, perm rbac.Permission) bool {
+	names, err := s.storage.RolesForManager(ctx, managerID)
+	if err != nil {
+		return false
+	}
+
+	for _, name := range names {
+		if role, ok := rbac.Roles[name]; ok && role.Has(perm) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//GrantRole присваивает менеджеру роль. role должна быть именем одной из
+//rbac.Roles, иначе возвращается ErrUnknownRole.
+func (s *Service) GrantRole(ctx context.Context, managerID int64, role string) error {
+	if _, ok := rbac.Roles[role]; !ok {
+		return ErrUnknownRole
+	}
+
+	return s.storage.GrantRole(ctx, managerID, role)
+}
+
+//RevokeRole снимает с менеджера роль. role должна быть именем одной из
+//rbac.Roles, иначе возвращается ErrUnknownRole.
+func (s *Service) RevokeRole(ctx context.Context, managerID int64, role string) error {
+	if _, ok := rbac.Roles[role]; !ok {
+		return ErrUnknownRole
+	}
+
+	return s.storage.RevokeRole(ctx, managerID, role)
+}
+
+//TokenForManager ... аналогично TokenForCustomer, но для менеджеров: после
+//проверки логина/пароля выпускает токен, который затем резолвится через
+//AuthenticateManager только в id менеджера. Разрешения в токен не
+//встраиваются: они проверяются отдельно, через HasPermission по ролям
+//менеджера на момент проверки.
+func (s *Service) TokenForManager(ctx context.Context, login, password string) (string, error) {
+	managerID, err := s.authManager(ctx, login, password)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := s.managerSessions.Create(ctx, managerID)
+	if err != nil {
+		return "", ErrInternal
+	}
+
+	return token, nil
+}
+
+//AuthenticateManager резолвит токен менеджера в его id.
+func (s *Service) AuthenticateManager(ctx context.Context, token string) (int64, error) {
+	id, err := s.managerSessions.Lookup(ctx, token)
+	if errors.Is(err, sessions.ErrNotFound) {
+		return 0, ErrNoSuchUser
+	}
+	if errors.Is(err, sessions.ErrExpired) {
+		return 0, ErrExpireToken
+	}
+	if err != nil {
+		return 0, ErrInternal
+	}
+
+	return id, nil
+}
@@ -0,0 +1,37 @@
+package rbac
+
+import (
+	"context"
+	"net/http"
+)
+
+//Authenticator резолвит токен менеджера в его id и проверяет разрешения.
+//customers.Service реализует этот интерфейс за счёт AuthenticateManager и
+//HasPermission.
+type Authenticator interface {
+	AuthenticateManager(ctx context.Context, token string) (managerID int64, err error)
+	HasPermission(ctx context.Context, managerID int64, perm Permission) bool
+}
+
+//RequirePermission возвращает middleware, пропускающий запрос дальше только
+//если токен в заголовке Authorization принадлежит менеджеру с разрешением perm.
+func RequirePermission(auth Authenticator, perm Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := r.Header.Get("Authorization")
+
+			managerID, err := auth.AuthenticateManager(r.Context(), token)
+			if err != nil {
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			if !auth.HasPermission(r.Context(), managerID, perm) {
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+//Package rbac описывает роли и разрешения менеджеров: до сих пор код не
+//различал admin и read-only менеджера, что блокировало многопользовательский
+//деплой.
+package rbac
+
+//Permission - разрешение, которым может обладать менеджер.
+type Permission string
+
+const (
+	//PermissionViewCustomers разрешает просмотр покупателей.
+	PermissionViewCustomers Permission = "customers:view"
+
+	//PermissionManageCustomers разрешает создание/изменение/удаление покупателей.
+	PermissionManageCustomers Permission = "customers:manage"
+
+	//PermissionManageManagers разрешает управлять другими менеджерами (роли, доступ).
+	PermissionManageManagers Permission = "managers:manage"
+)
+
+//Role - именованный набор разрешений.
+type Role struct {
+	Name        string
+	Permissions []Permission
+}
+
+//Has сообщает, входит ли perm в роль.
+func (r Role) Has(perm Permission) bool {
+	for _, p := range r.Permissions {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+//Admin - полный доступ.
+var Admin = Role{
+	Name: "admin",
+	Permissions: []Permission{
+		PermissionViewCustomers,
+		PermissionManageCustomers,
+		PermissionManageManagers,
+	},
+}
+
+//ReadOnly - только просмотр покупателей.
+var ReadOnly = Role{
+	Name:        "read-only",
+	Permissions: []Permission{PermissionViewCustomers},
+}
+
+//Roles - встроенные роли по имени. GrantRole/RevokeRole оперируют именами
+//из этой карты.
+var Roles = map[string]Role{
+	Admin.Name:    Admin,
+	ReadOnly.Name: ReadOnly,
+}
@@ -0,0 +1,85 @@
+package customers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/urunov-fedya/crud/pkg/customers/rbac"
+)
+
+//rbacFakeStorage - минимальная in-memory реализация Storage для тестов
+//HasPermission/GrantRole/RevokeRole. Методы, не участвующие в RBAC, не
+//используются этими тестами и не реализованы.
+type rbacFakeStorage struct {
+	Storage
+	roles map[int64][]string
+}
+
+func newRBACFakeStorage() *rbacFakeStorage {
+	return &rbacFakeStorage{roles: map[int64][]string{}}
+}
+
+func (s *rbacFakeStorage) RolesForManager(ctx context.Context, managerID int64) ([]string, error) {
+	return s.roles[managerID], nil
+}
+
+func (s *rbacFakeStorage) GrantRole(ctx context.Context, managerID int64, role string) error {
+	s.roles[managerID] = append(s.roles[managerID], role)
+	return nil
+}
+
+func (s *rbacFakeStorage) RevokeRole(ctx context.Context, managerID int64, role string) error {
+	kept := s.roles[managerID][:0]
+	for _, r := range s.roles[managerID] {
+		if r != role {
+			kept = append(kept, r)
+		}
+	}
+	s.roles[managerID] = kept
+	return nil
+}
+
+func TestGrantRole_UnknownRole(t *testing.T) {
+	storage := newRBACFakeStorage()
+	svc := &Service{storage: storage}
+
+	err := svc.GrantRole(context.Background(), 1, "typo-admin")
+	if !errors.Is(err, ErrUnknownRole) {
+		t.Fatalf("GrantRole err = %v, want ErrUnknownRole", err)
+	}
+	if len(storage.roles[1]) != 0 {
+		t.Fatal("GrantRole must not reach storage for an unknown role")
+	}
+}
+
+func TestGrantRole_KnownRole(t *testing.T) {
+	storage := newRBACFakeStorage()
+	svc := &Service{storage: storage}
+
+	if err := svc.GrantRole(context.Background(), 1, rbac.Admin.Name); err != nil {
+		t.Fatalf("GrantRole: %v", err)
+	}
+	if !svc.HasPermission(context.Background(), 1, rbac.PermissionManageCustomers) {
+		t.Fatal("manager granted admin should have PermissionManageCustomers")
+	}
+}
+
+func TestRevokeRole_UnknownRole(t *testing.T) {
+	storage := newRBACFakeStorage()
+	svc := &Service{storage: storage}
+
+	err := svc.RevokeRole(context.Background(), 1, "typo-admin")
+	if !errors.Is(err, ErrUnknownRole) {
+		t.Fatalf("RevokeRole err = %v, want ErrUnknownRole", err)
+	}
+}
+
+func TestHasPermission_NoRoles(t *testing.T) {
+	storage := newRBACFakeStorage()
+	svc := &Service{storage: storage}
+
+	if svc.HasPermission(context.Background(), 1, rbac.PermissionViewCustomers) {
+		t.Fatal("manager with no roles must not have any permission")
+	}
+}
@@ -2,15 +2,16 @@ package customers
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/hex"
+	"crypto/subtle"
 	"errors"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/jackc/pgx"
-	"github.com/jackc/pgx/v4/pgxpool"
 	"golang.org/x/crypto/bcrypt"
+
+	"github.com/urunov-fedya/crud/pkg/customers/notify"
+	"github.com/urunov-fedya/crud/pkg/customers/sessions"
 )
 
 var (
@@ -28,16 +29,35 @@ var (
 
 	//ErrExpireToken возвращается, когда время ожидания токена истекает
 	ErrExpireToken = errors.New("token expired")
+
+	//ErrInvalidResetToken возвращается, когда токен восстановления пароля
+	//не найден, уже использован или истёк.
+	ErrInvalidResetToken = errors.New("invalid reset token")
+
+	//ErrUnknownRole возвращается, когда GrantRole/RevokeRole вызывают с
+	//именем роли, которого нет в rbac.Roles.
+	ErrUnknownRole = errors.New("unknown role")
 )
 
+//PasswordResetTTL - время жизни токена восстановления пароля по умолчанию.
+const PasswordResetTTL = time.Hour
+
 //Service описывает сервис работы с покупателям.
 type Service struct {
-	pool *pgxpool.Pool
+	storage         Storage
+	sessions        sessions.SessionStore
+	managerSessions sessions.SessionStore
+	notifier        notify.Notifier
 }
 
-//NewService создаёт сервис.
-func NewService(pool *pgxpool.Pool) *Service {
-	return &Service{pool: pool}
+//NewService создаёт сервис. storage отвечает за хранение покупателей и
+//менеджеров (Postgres или SQLite, см. пакеты storage/postgres и
+//storage/sqlite3), customerSessions и managerSessions - за хранение и время
+//жизни токенов покупателей и менеджеров соответственно (обычно разные
+//таблицы одного и того же бэкенда, см. пакет sessions), notifier - за
+//доставку токена восстановления пароля (см. пакет notify).
+func NewService(storage Storage, customerSessions, managerSessions sessions.SessionStore, notifier notify.Notifier) *Service {
+	return &Service{storage: storage, sessions: customerSessions, managerSessions: managerSessions, notifier: notifier}
 }
 
 //Customer представляет информацию о покупателе.
@@ -51,214 +71,130 @@ type Customer struct {
 }
 
 //All ....
-func (s *Service) All(ctx context.Context) (cs []*Customer, err error) {
-
-	sqlStatement := `SELECT * FROM customers`
-
-	rows, err := s.pool.Query(ctx, sqlStatement)
-	if err != nil {
-		return nil, err
-	}
+func (s *Service) All(ctx context.Context) ([]*Customer, error) {
+	return s.storage.All(ctx)
+}
 
-	defer rows.Close()
-
-	for rows.Next() {
-		item := &Customer{}
-		err := rows.Scan(
-			&item.ID,
-			&item.Name,
-			&item.Phone,
-			&item.Active,
-			&item.Created,
-		)
-		if err != nil {
-			log.Println(err)
-		}
+//AllActive ....
+func (s *Service) AllActive(ctx context.Context) ([]*Customer, error) {
+	return s.storage.AllActive(ctx)
+}
 
-		cs = append(cs, item)
-	}
+//ByID ...
+func (s *Service) ByID(ctx context.Context, id int64) (*Customer, error) {
+	return s.storage.ByID(ctx, id)
+}
 
-	return cs, nil
+//ChangeActive ...
+func (s *Service) ChangeActive(ctx context.Context, id int64, active bool) (*Customer, error) {
+	return s.storage.ChangeActive(ctx, id, active)
 }
 
-//AllActive ....
-func (s *Service) AllActive(ctx context.Context) (cs []*Customer, err error) {
+//Delete ...
+func (s *Service) Delete(ctx context.Context, id int64) (*Customer, error) {
+	return s.storage.Delete(ctx, id)
+}
 
-	sqlStatement := `SELECT * FROM customers WHERE active`
+//Save ... Если customer.Password пуст (профильное обновление без смены
+//пароля - типичный путь All/AllActive/ByID -> изменить поле -> Save), хэш
+//не перезаписывается и хранящийся пароль остаётся прежним.
+func (s *Service) Save(ctx context.Context, customer *Customer) (*Customer, error) {
+	toSave := *customer
 
-	rows, err := s.pool.Query(ctx, sqlStatement)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		item := &Customer{}
-		err := rows.Scan(
-			&item.ID,
-			&item.Name,
-			&item.Phone,
-			&item.Active,
-			&item.Created,
-		)
+	if customer.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(customer.Password), bcrypt.DefaultCost)
 		if err != nil {
-			log.Println(err)
+			return nil, ErrInternal
 		}
-		cs = append(cs, item)
+		toSave.Password = string(hash)
 	}
 
-	return cs, nil
+	return s.storage.Save(ctx, &toSave)
 }
 
-//ByID ...
-func (s *Service) ByID(ctx context.Context, id int64) (*Customer, error) {
-	item := &Customer{}
-
-	sqlStatement := `SELECT id, name, phone, active, created FROM customers WHERE id = $1`
-	err := s.pool.QueryRow(ctx, sqlStatement, id).Scan(
-		&item.ID,
-		&item.Name,
-		&item.Phone,
-		&item.Active,
-		&item.Created,
-	)
-
-	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, ErrNotFound
-	}
-
-	if err != nil {
-		log.Print(err)
-		return nil, ErrInternal
-	}
-
-	return item, nil
+// Auth - sign in
+func (s *Service) Auth(login, password string) bool {
+	_, err := s.authManager(context.Background(), login, password)
+	return err == nil
 }
 
-//ChangeActive ...
-func (s *Service) ChangeActive(ctx context.Context, id int64, active bool) (*Customer, error) {
-	item := &Customer{}
-
-	sqlStatement := `UPDATE customers SET active = $2 where id = $1 RETURNING *`
-	err := s.pool.QueryRow(ctx, sqlStatement, id, active).Scan(
-		&item.ID,
-		&item.Name,
-		&item.Phone,
-		&item.Active,
-		&item.Created,
-	)
-
-	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, ErrNotFound
+//authManager проверяет логин/пароль менеджера и возвращает его id.
+//Хранящийся пароль может быть либо bcrypt-хэшем, либо (для ещё не
+//мигрировавших записей) исторической строкой в открытом виде - в этом
+//случае, после сравнения в постоянное время, пароль перехэшируется в bcrypt.
+func (s *Service) authManager(ctx context.Context, login, password string) (int64, error) {
+	id, stored, err := s.storage.ManagerPasswordByLogin(ctx, login)
+	if err != nil {
+		return 0, ErrInvalidPassword
 	}
 
-	if err != nil {
-		log.Print(err)
-		return nil, ErrInternal
+	if isBcryptHash(stored) {
+		if bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) != nil {
+			return 0, ErrInvalidPassword
+		}
+		return id, nil
 	}
-	return item, nil
-}
 
-//Delete ...
-func (s *Service) Delete(ctx context.Context, id int64) (*Customer, error) {
-	item := &Customer{}
-
-	sqlStatement := `DELETE FROM customers WHERE id = $1 RETURNING *`
-	err := s.pool.QueryRow(ctx, sqlStatement, id).Scan(
-		&item.ID,
-		&item.Name,
-		&item.Phone,
-		&item.Active,
-		&item.Created,
-	)
-
-	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, ErrNotFound
+	if subtle.ConstantTimeCompare([]byte(stored), []byte(password)) != 1 {
+		return 0, ErrInvalidPassword
 	}
 
-	if err != nil {
+	if err := s.RehashLegacyPasswords(ctx, id, password); err != nil {
 		log.Print(err)
-		return nil, ErrInternal
 	}
 
-	return item, nil
+	return id, nil
 }
 
-//Save ...
-func (s *Service) Save(ctx context.Context, customer *Customer) (c *Customer, err error) {
-
-	item := &Customer{}
-
-	if customer.ID == 0 {
-		sqlStatement := `INSERT INTO customers(name, phone, password) VALUES($1, $2, $3) RETURNING *`
-		err = s.pool.QueryRow(ctx, sqlStatement, customer.Name, customer.Phone, customer.Password).Scan(
-			&item.ID,
-			&item.Name,
-			&item.Phone,
-			&item.Password,
-			&item.Active,
-			&item.Created,
-		)
-	} else {
-		sqlStatement := `UPDATE customers SET name = $1, phone = $2, password = $3 WHERE id = $4 RETURNING *`
-		err = s.pool.QueryRow(ctx, sqlStatement, customer.Name, customer.Phone, customer.Password, customer.ID).Scan(
-			&item.ID,
-			&item.Name,
-			&item.Phone,
-			&item.Password,
-			&item.Active,
-			&item.Created,
-		)
+//RegisterManager заводит менеджера с паролем, захэшированным bcrypt.
+func (s *Service) RegisterManager(ctx context.Context, login, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return ErrInternal
 	}
 
-	if err != nil {
-		log.Print(err)
-		return nil, ErrInternal
+	if _, err := s.storage.CreateManager(ctx, login, string(hash)); err != nil {
+		return ErrInternal
 	}
 
-	return item, nil
+	return nil
 }
 
-// Auth - sign in
-func (s *Service) Auth(login, password string) bool {
-	query := `SELECT login, password FROM managers WHERE login=$1 and password=$2`
-
-	err := s.pool.QueryRow(context.Background(), query, login, password).Scan(&login, &password)
+//RehashLegacyPasswords перехэширует пароль менеджера managerID в bcrypt.
+//Вызывается из authManager (т.е. из Auth и TokenForManager) сразу после
+//успешной проверки пароля по legacy-схеме
+//(сравнение в открытом виде), чтобы учётные записи плавно переходили на
+//bcrypt без отдельной миграции по всей таблице managers.
+func (s *Service) RehashLegacyPasswords(ctx context.Context, managerID int64, plainPassword string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(plainPassword), bcrypt.DefaultCost)
 	if err != nil {
-		log.Print(err)
-		return false
+		return ErrInternal
 	}
 
-	return true
+	return s.storage.UpdateManagerPassword(ctx, managerID, string(hash))
+}
+
+//isBcryptHash отличает bcrypt-хэш ("$2a$10$...", длина 60) от исторических
+//значений, хранившихся в открытом виде.
+func isBcryptHash(password string) bool {
+	return len(password) == 60 && strings.HasPrefix(password, "$2")
 }
 
 //TokenForCustomer ....
 func (s *Service) TokenForCustomer(ctx context.Context, phone, password string) (string, error) {
-
-	var hash string
-	var id int64
-
-	err := s.pool.QueryRow(ctx, "SELECT id, password FROM customers WHERE phone = $1", phone).Scan(&id, &hash)
-
-	if err == pgx.ErrNoRows {
+	id, hash, err := s.storage.PasswordByPhone(ctx, phone)
+	if errors.Is(err, ErrNoSuchUser) {
 		return "", ErrNoSuchUser
 	}
 	if err != nil {
 		return "", ErrInternal
 	}
-	err = bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
-	if err != nil {
-		return "", ErrInvalidPassword
-	}
 
-	buffer := make([]byte, 256)
-	n, err := rand.Read(buffer)
-	if n != len(buffer) || err != nil {
-		return "", ErrInternal
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		return "", ErrInvalidPassword
 	}
 
-	token := hex.EncodeToString(buffer)
-	_, err = s.pool.Exec(ctx, "INSERT INTO customers_tokens(token, customer_id) VALUES($1, $2)", token, id)
+	token, err := s.sessions.Create(ctx, id)
 	if err != nil {
 		return "", ErrInternal
 	}
@@ -268,23 +204,16 @@ func (s *Service) TokenForCustomer(ctx context.Context, phone, password string)
 
 //AuthenticateCustomer ...
 func (s *Service) AuthenticateCustomer(ctx context.Context, tkn string) (int64, error) {
-	var id int64
-	var expire time.Time
-
-	err := s.pool.QueryRow(ctx, "SELECT customer_id, expire FROM customers_tokens WHERE token=$1", tkn).Scan(&id, &expire)
-	if err == pgx.ErrNoRows {
+	id, err := s.sessions.Lookup(ctx, tkn)
+	if errors.Is(err, sessions.ErrNotFound) {
 		return 0, ErrNoSuchUser
 	}
+	if errors.Is(err, sessions.ErrExpired) {
+		return 0, ErrExpireToken
+	}
 	if err != nil {
 		return 0, ErrInternal
 	}
 
-	tNow := time.Now().Format("2006-01-02 15:04:05")
-	tEnd := expire.Format("2006-01-02 15:04:05")
-
-	if tNow > tEnd {
-		return 0, ErrExpireToken
-	}
-
 	return id, nil
 }
@@ -0,0 +1,122 @@
+package customers
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+//serviceFakeStorage - минимальная in-memory реализация Storage для тестов
+//Save и authManager.
+type serviceFakeStorage struct {
+	Storage
+
+	customer *Customer
+
+	managerID       int64
+	managerPassword string
+	rehashed        string
+}
+
+//Save имитирует поведение storage/sqlite3 и storage/postgres: пустой
+//Password не затирает уже сохранённый хэш (см. COALESCE(NULLIF(...), password)
+//в обеих реализациях).
+func (s *serviceFakeStorage) Save(ctx context.Context, customer *Customer) (*Customer, error) {
+	saved := *customer
+	if saved.Password == "" && s.customer != nil {
+		saved.Password = s.customer.Password
+	}
+	s.customer = &saved
+	return &saved, nil
+}
+
+func (s *serviceFakeStorage) ManagerPasswordByLogin(ctx context.Context, login string) (int64, string, error) {
+	return s.managerID, s.managerPassword, nil
+}
+
+func (s *serviceFakeStorage) UpdateManagerPassword(ctx context.Context, managerID int64, passwordHash string) error {
+	s.rehashed = passwordHash
+	s.managerPassword = passwordHash
+	return nil
+}
+
+func TestSave_EmptyPasswordKeepsStoredHash(t *testing.T) {
+	storage := &serviceFakeStorage{}
+	svc := &Service{storage: storage}
+
+	if _, err := svc.Save(context.Background(), &Customer{ID: 1, Name: "Ann", Password: "s3cret"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	firstHash := storage.customer.Password
+	if firstHash == "" || firstHash == "s3cret" {
+		t.Fatalf("first Save did not bcrypt-hash the password: %q", firstHash)
+	}
+
+	//Профильное обновление без смены пароля: Password пуст, как при
+	//ByID -> изменить Name -> Save.
+	if _, err := svc.Save(context.Background(), &Customer{ID: 1, Name: "Ann Updated"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if storage.customer.Password != firstHash {
+		t.Fatalf("profile-only Save overwrote password hash: got %q, want %q", storage.customer.Password, firstHash)
+	}
+}
+
+func TestSave_NonEmptyPasswordRehashes(t *testing.T) {
+	storage := &serviceFakeStorage{}
+	svc := &Service{storage: storage}
+
+	if _, err := svc.Save(context.Background(), &Customer{ID: 1, Password: "old-pass"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	firstHash := storage.customer.Password
+
+	if _, err := svc.Save(context.Background(), &Customer{ID: 1, Password: "new-pass"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if storage.customer.Password == firstHash {
+		t.Fatal("Save did not rehash a newly supplied password")
+	}
+	if bcrypt.CompareHashAndPassword([]byte(storage.customer.Password), []byte("new-pass")) != nil {
+		t.Fatal("stored hash does not match the new password")
+	}
+}
+
+func TestAuthManager_UpgradesLegacyPlaintextPassword(t *testing.T) {
+	storage := &serviceFakeStorage{managerID: 7, managerPassword: "legacy-plaintext"}
+	svc := &Service{storage: storage}
+
+	id, err := svc.authManager(context.Background(), "manager@example.com", "legacy-plaintext")
+	if err != nil {
+		t.Fatalf("authManager: %v", err)
+	}
+	if id != 7 {
+		t.Fatalf("authManager id = %d, want 7", id)
+	}
+
+	if storage.rehashed == "" {
+		t.Fatal("authManager did not trigger a rehash for a legacy plaintext password")
+	}
+	if !isBcryptHash(storage.rehashed) {
+		t.Fatalf("rehashed password is not a bcrypt hash: %q", storage.rehashed)
+	}
+
+	//Повторная аутентификация теперь должна идти по bcrypt-ветке.
+	if _, err := svc.authManager(context.Background(), "manager@example.com", "legacy-plaintext"); err != nil {
+		t.Fatalf("authManager after rehash: %v", err)
+	}
+}
+
+func TestAuthManager_BcryptHashRejectsWrongPassword(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct"), bcrypt.DefaultCost)
+	if err != nil {
+		t.Fatalf("bcrypt.GenerateFromPassword: %v", err)
+	}
+	storage := &serviceFakeStorage{managerID: 7, managerPassword: string(hash)}
+	svc := &Service{storage: storage}
+
+	if _, err := svc.authManager(context.Background(), "manager@example.com", "wrong"); err != ErrInvalidPassword {
+		t.Fatalf("authManager err = %v, want ErrInvalidPassword", err)
+	}
+}
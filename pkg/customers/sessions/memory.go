@@ -0,0 +1,138 @@
+package sessions
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type memoryEntry struct {
+	customerID int64
+	expire     time.Time
+}
+
+//MemoryStore - in-memory реализация SessionStore для тестов и небольших
+//деплоев без Postgres. Протухшие токены вычищаются в фоне по тику, а при
+//Shutdown накопленные токены переносятся в Postgres, если он указан.
+type MemoryStore struct {
+	mu    sync.Mutex
+	items map[string]*memoryEntry
+
+	ttl  time.Duration
+	tick time.Duration
+
+	persist *PostgresStore //может быть nil, тогда Shutdown ничего не сохраняет
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+//NewMemoryStore создаёт in-memory хранилище токенов.
+//ttl задаёт время жизни токена, tick - частоту фоновой зачистки протухших
+//токенов, persist (может быть nil) - куда перенести состояние при Shutdown.
+func NewMemoryStore(ttl, tick time.Duration, persist *PostgresStore) *MemoryStore {
+	s := &MemoryStore{
+		items:   make(map[string]*memoryEntry),
+		ttl:     ttl,
+		tick:    tick,
+		persist: persist,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go s.reap()
+	return s
+}
+
+//Create ...
+func (s *MemoryStore) Create(ctx context.Context, customerID int64) (string, error) {
+	buffer := make([]byte, 256)
+	n, err := rand.Read(buffer)
+	if n != len(buffer) || err != nil {
+		return "", ErrInternal
+	}
+	token := hex.EncodeToString(buffer)
+
+	s.mu.Lock()
+	s.items[token] = &memoryEntry{customerID: customerID, expire: time.Now().Add(s.ttl)}
+	s.mu.Unlock()
+
+	return token, nil
+}
+
+//Lookup ...
+func (s *MemoryStore) Lookup(ctx context.Context, token string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.items[token]
+	if !ok {
+		return 0, ErrNotFound
+	}
+	if time.Now().After(item.expire) {
+		delete(s.items, token)
+		return 0, ErrExpired
+	}
+
+	return item.customerID, nil
+}
+
+//Revoke ...
+func (s *MemoryStore) Revoke(ctx context.Context, token string) error {
+	s.mu.Lock()
+	delete(s.items, token)
+	s.mu.Unlock()
+	return nil
+}
+
+//Shutdown останавливает фоновый reaper и, если задан persist, переносит
+//ещё живые токены в Postgres, чтобы сессии не терялись при перезапуске.
+func (s *MemoryStore) Shutdown(ctx context.Context) error {
+	close(s.stop)
+	<-s.done
+
+	if s.persist == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := fmt.Sprintf("INSERT INTO %s(token, %s, expire) VALUES($1, $2, $3) ON CONFLICT (token) DO NOTHING",
+		s.persist.table, s.persist.idColumn)
+
+	for token, item := range s.items {
+		if time.Now().After(item.expire) {
+			continue
+		}
+		if _, err := s.persist.pool.Exec(ctx, query, token, item.customerID, item.expire); err != nil {
+			return ErrInternal
+		}
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) reap() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case now := <-ticker.C:
+			s.mu.Lock()
+			for token, item := range s.items {
+				if now.After(item.expire) {
+					delete(s.items, token)
+				}
+			}
+			s.mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_CreateLookup(t *testing.T) {
+	s := NewMemoryStore(time.Hour, time.Hour, nil)
+	defer s.Shutdown(context.Background())
+
+	token, err := s.Create(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	id, err := s.Lookup(context.Background(), token)
+	if err != nil {
+		t.Fatalf("Lookup: %v", err)
+	}
+	if id != 42 {
+		t.Fatalf("Lookup id = %d, want 42", id)
+	}
+}
+
+func TestMemoryStore_LookupNotFound(t *testing.T) {
+	s := NewMemoryStore(time.Hour, time.Hour, nil)
+	defer s.Shutdown(context.Background())
+
+	if _, err := s.Lookup(context.Background(), "bogus"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Lookup err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestMemoryStore_Expiry(t *testing.T) {
+	s := NewMemoryStore(time.Millisecond, time.Hour, nil)
+	defer s.Shutdown(context.Background())
+
+	token, err := s.Create(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	if _, err := s.Lookup(context.Background(), token); !errors.Is(err, ErrExpired) {
+		t.Fatalf("Lookup err = %v, want ErrExpired", err)
+	}
+}
+
+func TestMemoryStore_Reap(t *testing.T) {
+	s := NewMemoryStore(time.Millisecond, 5*time.Millisecond, nil)
+	defer s.Shutdown(context.Background())
+
+	token, err := s.Create(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	s.mu.Lock()
+	_, stillThere := s.items[token]
+	s.mu.Unlock()
+
+	if stillThere {
+		t.Fatal("reaper did not remove expired token from items")
+	}
+}
+
+func TestMemoryStore_ShutdownWithoutPersist(t *testing.T) {
+	s := NewMemoryStore(time.Hour, time.Hour, nil)
+
+	if err := s.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	//done закрыт, значит фоновый reap() завершился.
+	select {
+	case <-s.done:
+	default:
+		t.Fatal("reap goroutine did not stop")
+	}
+}
+
+func TestMemoryStore_ShutdownSkipsExpiredEntries(t *testing.T) {
+	persist := &PostgresStore{table: "customers_tokens", idColumn: "customer_id"}
+
+	s := NewMemoryStore(time.Millisecond, time.Hour, persist)
+
+	if _, err := s.Create(context.Background(), 7); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	//Все токены уже истекли, так что Shutdown не должен пытаться
+	//сохранить их через persist.pool (который здесь nil) и не должен
+	//вернуть ошибку.
+	if err := s.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+}
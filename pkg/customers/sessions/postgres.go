@@ -0,0 +1,97 @@
+package sessions
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+//TokenTTL - время жизни токена по умолчанию.
+const TokenTTL = 7 * 24 * time.Hour
+
+//PostgresStore хранит токены в таблице table (customer_id/manager_id
+//указывается через idColumn), см. NewPostgresStore и NewManagerPostgresStore.
+type PostgresStore struct {
+	pool     *pgxpool.Pool
+	ttl      time.Duration
+	table    string
+	idColumn string
+}
+
+//NewPostgresStore создаёт хранилище токенов покупателей поверх пула pgx
+//(таблица customers_tokens).
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return newPostgresStore(pool, "customers_tokens", "customer_id")
+}
+
+//NewManagerPostgresStore создаёт хранилище токенов менеджеров поверх пула
+//pgx (таблица managers_tokens).
+func NewManagerPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return newPostgresStore(pool, "managers_tokens", "manager_id")
+}
+
+func newPostgresStore(pool *pgxpool.Pool, table, idColumn string) *PostgresStore {
+	return &PostgresStore{pool: pool, ttl: TokenTTL, table: table, idColumn: idColumn}
+}
+
+//Create ...
+func (s *PostgresStore) Create(ctx context.Context, id int64) (string, error) {
+	buffer := make([]byte, 256)
+	n, err := rand.Read(buffer)
+	if n != len(buffer) || err != nil {
+		return "", ErrInternal
+	}
+
+	token := hex.EncodeToString(buffer)
+	expire := time.Now().Add(s.ttl)
+
+	query := fmt.Sprintf("INSERT INTO %s(token, %s, expire) VALUES($1, $2, $3)", s.table, s.idColumn)
+	if _, err := s.pool.Exec(ctx, query, token, id, expire); err != nil {
+		return "", ErrInternal
+	}
+
+	return token, nil
+}
+
+//Lookup ...
+func (s *PostgresStore) Lookup(ctx context.Context, token string) (int64, error) {
+	var id int64
+	var expire time.Time
+
+	query := fmt.Sprintf("SELECT %s, expire FROM %s WHERE token=$1", s.idColumn, s.table)
+	err := s.pool.QueryRow(ctx, query, token).Scan(&id, &expire)
+	if err == pgx.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, ErrInternal
+	}
+
+	//Сравниваем реальные time.Time, а не отформатированные строки:
+	//предыдущая реализация сравнивала "2006-01-02 15:04:05" лексикографически,
+	//что ломалось на разнице часовых поясов между приложением и базой.
+	if time.Now().After(expire) {
+		return 0, ErrExpired
+	}
+
+	return id, nil
+}
+
+//Revoke ...
+func (s *PostgresStore) Revoke(ctx context.Context, token string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE token=$1", s.table)
+	if _, err := s.pool.Exec(ctx, query, token); err != nil {
+		return ErrInternal
+	}
+	return nil
+}
+
+//Shutdown для PostgresStore не делает ничего: данные уже находятся в Postgres.
+func (s *PostgresStore) Shutdown(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,36 @@
+//Package sessions описывает хранилище токенов покупателей, отвязанное от
+//конкретной реализации: production использует pgx, а тесты могут подменить
+//его на in-memory хранилище.
+package sessions
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	//ErrNotFound возвращается, когда токен не найден.
+	ErrNotFound = errors.New("session not found")
+
+	//ErrExpired возвращается, когда токен найден, но уже истёк.
+	ErrExpired = errors.New("session expired")
+
+	//ErrInternal возвращается при внутренней ошибке хранилища.
+	ErrInternal = errors.New("internal error")
+)
+
+//SessionStore описывает хранилище токенов покупателей.
+type SessionStore interface {
+	//Create выпускает новый токен для покупателя customerID.
+	Create(ctx context.Context, customerID int64) (token string, err error)
+
+	//Lookup возвращает id покупателя по токену, если тот существует и не истёк.
+	Lookup(ctx context.Context, token string) (customerID int64, err error)
+
+	//Revoke отзывает токен.
+	Revoke(ctx context.Context, token string) error
+
+	//Shutdown останавливает фоновые процессы хранилища, если они есть,
+	//и сохраняет текущее состояние, если реализация это поддерживает.
+	Shutdown(ctx context.Context) error
+}
@@ -0,0 +1,72 @@
+package customers
+
+import (
+	"context"
+	"time"
+)
+
+//Storage абстрагирует доступ к хранилищу покупателей и менеджеров от
+//конкретной СУБД. Это позволяет Service работать как с Postgres (pgx), так
+//и с SQLite (для тестов и небольших деплоев без Postgres), не меняя свой
+//код: см. пакеты storage/postgres и storage/sqlite3.
+type Storage interface {
+	//All возвращает всех покупателей.
+	All(ctx context.Context) ([]*Customer, error)
+
+	//AllActive возвращает активных покупателей.
+	AllActive(ctx context.Context) ([]*Customer, error)
+
+	//ByID возвращает покупателя по id.
+	ByID(ctx context.Context, id int64) (*Customer, error)
+
+	//Save сохраняет покупателя (вставляет, если ID == 0, иначе обновляет).
+	Save(ctx context.Context, customer *Customer) (*Customer, error)
+
+	//ChangeActive включает/выключает покупателя.
+	ChangeActive(ctx context.Context, id int64, active bool) (*Customer, error)
+
+	//Delete удаляет покупателя.
+	Delete(ctx context.Context, id int64) (*Customer, error)
+
+	//PasswordByPhone возвращает id покупателя и хэш его пароля по номеру
+	//телефона. Используется TokenForCustomer перед выпуском токена.
+	PasswordByPhone(ctx context.Context, phone string) (id int64, hash string, err error)
+
+	//ManagerPasswordByLogin возвращает id менеджера и хранящийся пароль
+	//(bcrypt-хэш либо, для ещё не мигрировавших записей, исторический
+	//plaintext) по логину.
+	ManagerPasswordByLogin(ctx context.Context, login string) (managerID int64, password string, err error)
+
+	//UpdateManagerPassword перезаписывает пароль менеджера (используется и
+	//для первичного bcrypt-хэша, и для миграции legacy-записей).
+	UpdateManagerPassword(ctx context.Context, managerID int64, passwordHash string) error
+
+	//CreateManager заводит нового менеджера с уже захэшированным паролем.
+	CreateManager(ctx context.Context, login, passwordHash string) (managerID int64, err error)
+
+	//RolesForManager возвращает имена ролей, присвоенных менеджеру.
+	RolesForManager(ctx context.Context, managerID int64) ([]string, error)
+
+	//GrantRole присваивает менеджеру роль.
+	GrantRole(ctx context.Context, managerID int64, role string) error
+
+	//RevokeRole снимает с менеджера роль.
+	RevokeRole(ctx context.Context, managerID int64, role string) error
+
+	//UpdatePassword перезаписывает хэш пароля покупателя.
+	UpdatePassword(ctx context.Context, customerID int64, passwordHash string) error
+
+	//CreatePasswordReset заводит одноразовый токен восстановления пароля.
+	//Хранится только его хэш, не сам токен.
+	CreatePasswordReset(ctx context.Context, customerID int64, tokenHash string, expiresAt time.Time) error
+
+	//PasswordResetByHash возвращает покупателя, срок действия и признак
+	//использования токена восстановления по его хэшу.
+	PasswordResetByHash(ctx context.Context, tokenHash string) (customerID int64, expiresAt time.Time, used bool, err error)
+
+	//MarkPasswordResetUsed атомарно помечает токен восстановления
+	//использованным (WHERE used_at IS NULL) и сообщает, был ли он
+	//действительно ещё не использован - это единственный надёжный гейт
+	//одноразовости токена при конкурентных вызовах ResetPassword.
+	MarkPasswordResetUsed(ctx context.Context, tokenHash string) (ok bool, err error)
+}
@@ -0,0 +1,287 @@
+//Package postgres реализует customers.Storage поверх пула pgx. Это прежнее
+//поведение Service, просто вынесенное за интерфейс, чтобы рядом могли жить
+//другие реализации (см. storage/sqlite3).
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/urunov-fedya/crud/pkg/customers"
+)
+
+//Store реализует customers.Storage на базе Postgres.
+type Store struct {
+	pool *pgxpool.Pool
+}
+
+//NewStore создаёт Store поверх уже открытого пула pgx.
+func NewStore(pool *pgxpool.Pool) *Store {
+	return &Store{pool: pool}
+}
+
+//All ...
+func (s *Store) All(ctx context.Context) (cs []*customers.Customer, err error) {
+	sqlStatement := `SELECT * FROM customers`
+
+	rows, err := s.pool.Query(ctx, sqlStatement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := &customers.Customer{}
+		if err := rows.Scan(&item.ID, &item.Name, &item.Phone, &item.Active, &item.Created); err != nil {
+			log.Println(err)
+		}
+		cs = append(cs, item)
+	}
+
+	return cs, nil
+}
+
+//AllActive ...
+func (s *Store) AllActive(ctx context.Context) (cs []*customers.Customer, err error) {
+	sqlStatement := `SELECT * FROM customers WHERE active`
+
+	rows, err := s.pool.Query(ctx, sqlStatement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := &customers.Customer{}
+		if err := rows.Scan(&item.ID, &item.Name, &item.Phone, &item.Active, &item.Created); err != nil {
+			log.Println(err)
+		}
+		cs = append(cs, item)
+	}
+
+	return cs, nil
+}
+
+//ByID ...
+func (s *Store) ByID(ctx context.Context, id int64) (*customers.Customer, error) {
+	item := &customers.Customer{}
+
+	sqlStatement := `SELECT id, name, phone, active, created FROM customers WHERE id = $1`
+	err := s.pool.QueryRow(ctx, sqlStatement, id).Scan(&item.ID, &item.Name, &item.Phone, &item.Active, &item.Created)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, customers.ErrNotFound
+	}
+	if err != nil {
+		log.Print(err)
+		return nil, customers.ErrInternal
+	}
+
+	return item, nil
+}
+
+//ChangeActive ...
+func (s *Store) ChangeActive(ctx context.Context, id int64, active bool) (*customers.Customer, error) {
+	item := &customers.Customer{}
+
+	sqlStatement := `UPDATE customers SET active = $2 where id = $1 RETURNING *`
+	err := s.pool.QueryRow(ctx, sqlStatement, id, active).Scan(&item.ID, &item.Name, &item.Phone, &item.Active, &item.Created)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, customers.ErrNotFound
+	}
+	if err != nil {
+		log.Print(err)
+		return nil, customers.ErrInternal
+	}
+
+	return item, nil
+}
+
+//Delete ...
+func (s *Store) Delete(ctx context.Context, id int64) (*customers.Customer, error) {
+	item := &customers.Customer{}
+
+	sqlStatement := `DELETE FROM customers WHERE id = $1 RETURNING *`
+	err := s.pool.QueryRow(ctx, sqlStatement, id).Scan(&item.ID, &item.Name, &item.Phone, &item.Active, &item.Created)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, customers.ErrNotFound
+	}
+	if err != nil {
+		log.Print(err)
+		return nil, customers.ErrInternal
+	}
+
+	return item, nil
+}
+
+//Save ...
+func (s *Store) Save(ctx context.Context, customer *customers.Customer) (*customers.Customer, error) {
+	item := &customers.Customer{}
+
+	var err error
+	if customer.ID == 0 {
+		sqlStatement := `INSERT INTO customers(name, phone, password) VALUES($1, $2, $3) RETURNING *`
+		err = s.pool.QueryRow(ctx, sqlStatement, customer.Name, customer.Phone, customer.Password).Scan(
+			&item.ID, &item.Name, &item.Phone, &item.Password, &item.Active, &item.Created)
+	} else {
+		sqlStatement := `UPDATE customers SET name = $1, phone = $2, password = COALESCE(NULLIF($3, ''), password) WHERE id = $4 RETURNING *`
+		err = s.pool.QueryRow(ctx, sqlStatement, customer.Name, customer.Phone, customer.Password, customer.ID).Scan(
+			&item.ID, &item.Name, &item.Phone, &item.Password, &item.Active, &item.Created)
+	}
+
+	if err != nil {
+		log.Print(err)
+		return nil, customers.ErrInternal
+	}
+
+	return item, nil
+}
+
+//PasswordByPhone ...
+func (s *Store) PasswordByPhone(ctx context.Context, phone string) (int64, string, error) {
+	var id int64
+	var hash string
+
+	err := s.pool.QueryRow(ctx, "SELECT id, password FROM customers WHERE phone = $1", phone).Scan(&id, &hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, "", customers.ErrNoSuchUser
+	}
+	if err != nil {
+		return 0, "", customers.ErrInternal
+	}
+
+	return id, hash, nil
+}
+
+//ManagerPasswordByLogin ...
+func (s *Store) ManagerPasswordByLogin(ctx context.Context, login string) (int64, string, error) {
+	var id int64
+	var password string
+
+	err := s.pool.QueryRow(ctx, `SELECT id, password FROM managers WHERE login = $1`, login).Scan(&id, &password)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, "", customers.ErrNoSuchUser
+	}
+	if err != nil {
+		return 0, "", customers.ErrInternal
+	}
+
+	return id, password, nil
+}
+
+//UpdateManagerPassword ...
+func (s *Store) UpdateManagerPassword(ctx context.Context, managerID int64, passwordHash string) error {
+	_, err := s.pool.Exec(ctx, `UPDATE managers SET password = $1 WHERE id = $2`, passwordHash, managerID)
+	if err != nil {
+		return customers.ErrInternal
+	}
+	return nil
+}
+
+//CreateManager ...
+func (s *Store) CreateManager(ctx context.Context, login, passwordHash string) (int64, error) {
+	var id int64
+
+	err := s.pool.QueryRow(ctx, `INSERT INTO managers(login, password) VALUES($1, $2) RETURNING id`, login, passwordHash).Scan(&id)
+	if err != nil {
+		return 0, customers.ErrInternal
+	}
+
+	return id, nil
+}
+
+//RolesForManager ...
+func (s *Store) RolesForManager(ctx context.Context, managerID int64) ([]string, error) {
+	rows, err := s.pool.Query(ctx, `SELECT role FROM manager_roles WHERE manager_id = $1`, managerID)
+	if err != nil {
+		return nil, customers.ErrInternal
+	}
+	defer rows.Close()
+
+	var roles []string
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, customers.ErrInternal
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+//GrantRole ...
+func (s *Store) GrantRole(ctx context.Context, managerID int64, role string) error {
+	_, err := s.pool.Exec(ctx,
+		`INSERT INTO manager_roles(manager_id, role) VALUES($1, $2) ON CONFLICT DO NOTHING`, managerID, role)
+	if err != nil {
+		return customers.ErrInternal
+	}
+	return nil
+}
+
+//RevokeRole ...
+func (s *Store) RevokeRole(ctx context.Context, managerID int64, role string) error {
+	_, err := s.pool.Exec(ctx, `DELETE FROM manager_roles WHERE manager_id = $1 AND role = $2`, managerID, role)
+	if err != nil {
+		return customers.ErrInternal
+	}
+	return nil
+}
+
+//UpdatePassword ...
+func (s *Store) UpdatePassword(ctx context.Context, customerID int64, passwordHash string) error {
+	_, err := s.pool.Exec(ctx, `UPDATE customers SET password = $1 WHERE id = $2`, passwordHash, customerID)
+	if err != nil {
+		return customers.ErrInternal
+	}
+	return nil
+}
+
+//CreatePasswordReset ...
+func (s *Store) CreatePasswordReset(ctx context.Context, customerID int64, tokenHash string, expiresAt time.Time) error {
+	_, err := s.pool.Exec(ctx,
+		`INSERT INTO customers_password_resets(token_hash, customer_id, expires_at) VALUES($1, $2, $3)`,
+		tokenHash, customerID, expiresAt)
+	if err != nil {
+		return customers.ErrInternal
+	}
+	return nil
+}
+
+//PasswordResetByHash ...
+func (s *Store) PasswordResetByHash(ctx context.Context, tokenHash string) (int64, time.Time, bool, error) {
+	var customerID int64
+	var expiresAt time.Time
+	var usedAt *time.Time
+
+	err := s.pool.QueryRow(ctx,
+		`SELECT customer_id, expires_at, used_at FROM customers_password_resets WHERE token_hash = $1`,
+		tokenHash).Scan(&customerID, &expiresAt, &usedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, time.Time{}, false, customers.ErrNotFound
+	}
+	if err != nil {
+		return 0, time.Time{}, false, customers.ErrInternal
+	}
+
+	return customerID, expiresAt, usedAt != nil, nil
+}
+
+//MarkPasswordResetUsed ...
+func (s *Store) MarkPasswordResetUsed(ctx context.Context, tokenHash string) (bool, error) {
+	tag, err := s.pool.Exec(ctx,
+		`UPDATE customers_password_resets SET used_at = now() WHERE token_hash = $1 AND used_at IS NULL`, tokenHash)
+	if err != nil {
+		return false, customers.ErrInternal
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,295 @@
+//Package sqlite3 реализует customers.Storage поверх mattn/go-sqlite3, чтобы
+//сервис мог работать в тестах и небольших деплоях без поднятого Postgres.
+//Схема накатывается миграциями из migrations/ при Open.
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/urunov-fedya/crud/pkg/customers"
+)
+
+//Store реализует customers.Storage на базе SQLite.
+type Store struct {
+	db *sql.DB
+}
+
+//Open открывает (или создаёт) файл базы по пути dsn и накатывает на него
+//недостающие миграции.
+func Open(dsn string) (*Store, error) {
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrate(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Store{db: db}, nil
+}
+
+//Close закрывает соединение с базой.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+//All ...
+func (s *Store) All(ctx context.Context) (cs []*customers.Customer, err error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT id, name, phone, active, created FROM customers`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := &customers.Customer{}
+		if err := rows.Scan(&item.ID, &item.Name, &item.Phone, &item.Active, &item.Created); err != nil {
+			return nil, err
+		}
+		cs = append(cs, item)
+	}
+
+	return cs, nil
+}
+
+//AllActive ...
+func (s *Store) AllActive(ctx context.Context) (cs []*customers.Customer, err error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT id, name, phone, active, created FROM customers WHERE active`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := &customers.Customer{}
+		if err := rows.Scan(&item.ID, &item.Name, &item.Phone, &item.Active, &item.Created); err != nil {
+			return nil, err
+		}
+		cs = append(cs, item)
+	}
+
+	return cs, nil
+}
+
+//ByID ...
+func (s *Store) ByID(ctx context.Context, id int64) (*customers.Customer, error) {
+	item := &customers.Customer{}
+
+	row := s.db.QueryRowContext(ctx, `SELECT id, name, phone, active, created FROM customers WHERE id = ?`, id)
+	err := row.Scan(&item.ID, &item.Name, &item.Phone, &item.Active, &item.Created)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, customers.ErrNotFound
+	}
+	if err != nil {
+		return nil, customers.ErrInternal
+	}
+
+	return item, nil
+}
+
+//ChangeActive ...
+func (s *Store) ChangeActive(ctx context.Context, id int64, active bool) (*customers.Customer, error) {
+	_, err := s.db.ExecContext(ctx, `UPDATE customers SET active = ? WHERE id = ?`, active, id)
+	if err != nil {
+		return nil, customers.ErrInternal
+	}
+
+	return s.ByID(ctx, id)
+}
+
+//Delete ...
+func (s *Store) Delete(ctx context.Context, id int64) (*customers.Customer, error) {
+	item, err := s.ByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM customers WHERE id = ?`, id); err != nil {
+		return nil, customers.ErrInternal
+	}
+
+	return item, nil
+}
+
+//Save ...
+func (s *Store) Save(ctx context.Context, customer *customers.Customer) (*customers.Customer, error) {
+	if customer.ID == 0 {
+		res, err := s.db.ExecContext(ctx, `INSERT INTO customers(name, phone, password) VALUES(?, ?, ?)`,
+			customer.Name, customer.Phone, customer.Password)
+		if err != nil {
+			return nil, customers.ErrInternal
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return nil, customers.ErrInternal
+		}
+		return s.ByID(ctx, id)
+	}
+
+	if _, err := s.db.ExecContext(ctx,
+		`UPDATE customers SET name = ?, phone = ?, password = COALESCE(NULLIF(?, ''), password) WHERE id = ?`,
+		customer.Name, customer.Phone, customer.Password, customer.ID); err != nil {
+		return nil, customers.ErrInternal
+	}
+
+	return s.ByID(ctx, customer.ID)
+}
+
+//PasswordByPhone ...
+func (s *Store) PasswordByPhone(ctx context.Context, phone string) (int64, string, error) {
+	var id int64
+	var hash string
+
+	row := s.db.QueryRowContext(ctx, `SELECT id, password FROM customers WHERE phone = ?`, phone)
+	err := row.Scan(&id, &hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, "", customers.ErrNoSuchUser
+	}
+	if err != nil {
+		return 0, "", customers.ErrInternal
+	}
+
+	return id, hash, nil
+}
+
+//ManagerPasswordByLogin ...
+func (s *Store) ManagerPasswordByLogin(ctx context.Context, login string) (int64, string, error) {
+	var id int64
+	var password string
+
+	row := s.db.QueryRowContext(ctx, `SELECT id, password FROM managers WHERE login = ?`, login)
+	err := row.Scan(&id, &password)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, "", customers.ErrNoSuchUser
+	}
+	if err != nil {
+		return 0, "", customers.ErrInternal
+	}
+
+	return id, password, nil
+}
+
+//UpdateManagerPassword ...
+func (s *Store) UpdateManagerPassword(ctx context.Context, managerID int64, passwordHash string) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE managers SET password = ? WHERE id = ?`, passwordHash, managerID)
+	if err != nil {
+		return customers.ErrInternal
+	}
+	return nil
+}
+
+//CreateManager ...
+func (s *Store) CreateManager(ctx context.Context, login, passwordHash string) (int64, error) {
+	res, err := s.db.ExecContext(ctx, `INSERT INTO managers(login, password) VALUES(?, ?)`, login, passwordHash)
+	if err != nil {
+		return 0, customers.ErrInternal
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, customers.ErrInternal
+	}
+
+	return id, nil
+}
+
+//RolesForManager ...
+func (s *Store) RolesForManager(ctx context.Context, managerID int64) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT role FROM manager_roles WHERE manager_id = ?`, managerID)
+	if err != nil {
+		return nil, customers.ErrInternal
+	}
+	defer rows.Close()
+
+	var roles []string
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, customers.ErrInternal
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+//GrantRole ...
+func (s *Store) GrantRole(ctx context.Context, managerID int64, role string) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT OR IGNORE INTO manager_roles(manager_id, role) VALUES(?, ?)`, managerID, role)
+	if err != nil {
+		return customers.ErrInternal
+	}
+	return nil
+}
+
+//RevokeRole ...
+func (s *Store) RevokeRole(ctx context.Context, managerID int64, role string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM manager_roles WHERE manager_id = ? AND role = ?`, managerID, role)
+	if err != nil {
+		return customers.ErrInternal
+	}
+	return nil
+}
+
+//UpdatePassword ...
+func (s *Store) UpdatePassword(ctx context.Context, customerID int64, passwordHash string) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE customers SET password = ? WHERE id = ?`, passwordHash, customerID)
+	if err != nil {
+		return customers.ErrInternal
+	}
+	return nil
+}
+
+//CreatePasswordReset ...
+func (s *Store) CreatePasswordReset(ctx context.Context, customerID int64, tokenHash string, expiresAt time.Time) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO customers_password_resets(token_hash, customer_id, expires_at) VALUES(?, ?, ?)`,
+		tokenHash, customerID, expiresAt)
+	if err != nil {
+		return customers.ErrInternal
+	}
+	return nil
+}
+
+//PasswordResetByHash ...
+func (s *Store) PasswordResetByHash(ctx context.Context, tokenHash string) (int64, time.Time, bool, error) {
+	var customerID int64
+	var expiresAt time.Time
+	var usedAt *time.Time
+
+	row := s.db.QueryRowContext(ctx,
+		`SELECT customer_id, expires_at, used_at FROM customers_password_resets WHERE token_hash = ?`, tokenHash)
+	err := row.Scan(&customerID, &expiresAt, &usedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, time.Time{}, false, customers.ErrNotFound
+	}
+	if err != nil {
+		return 0, time.Time{}, false, customers.ErrInternal
+	}
+
+	return customerID, expiresAt, usedAt != nil, nil
+}
+
+//MarkPasswordResetUsed ...
+func (s *Store) MarkPasswordResetUsed(ctx context.Context, tokenHash string) (bool, error) {
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE customers_password_resets SET used_at = CURRENT_TIMESTAMP WHERE token_hash = ? AND used_at IS NULL`, tokenHash)
+	if err != nil {
+		return false, customers.ErrInternal
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, customers.ErrInternal
+	}
+
+	return affected > 0, nil
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/urunov-fedya/crud/pkg/customers"
+	"github.com/urunov-fedya/crud/pkg/customers/rbac"
+)
+
+//publicMethods - методы, не требующие токена: выдача токена покупателю
+//должна быть доступна анонимно.
+var publicMethods = map[string]bool{
+	"/customers.v1.CustomersService/TokenForCustomer": true,
+}
+
+//managerPermissions сопоставляет административные CRUD-методы над всей
+//таблицей покупателей разрешению менеджера, необходимому для их вызова:
+//это admin-доступ над чужими записями, а не "какой-то валидный токен
+//покупателя" - см. пакет rbac.
+var managerPermissions = map[string]rbac.Permission{
+	"/customers.v1.CustomersService/All":          rbac.PermissionViewCustomers,
+	"/customers.v1.CustomersService/AllActive":    rbac.PermissionViewCustomers,
+	"/customers.v1.CustomersService/ByID":         rbac.PermissionViewCustomers,
+	"/customers.v1.CustomersService/ChangeActive": rbac.PermissionManageCustomers,
+	"/customers.v1.CustomersService/Delete":       rbac.PermissionManageCustomers,
+	"/customers.v1.CustomersService/Save":         rbac.PermissionManageCustomers,
+}
+
+//TokenAuthInterceptor авторизует все методы, кроме publicMethods: методы из
+//managerPermissions требуют токен менеджера с соответствующим разрешением
+//(svc.AuthenticateManager + svc.HasPermission), остальные (например,
+//AuthenticateCustomer) - токен покупателя (svc.AuthenticateCustomer).
+func TokenAuthInterceptor(svc *customers.Service) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok || len(md.Get("authorization")) == 0 {
+			return nil, status.Error(codes.Unauthenticated, customers.ErrNoSuchUser.Error())
+		}
+		token := md.Get("authorization")[0]
+
+		if perm, ok := managerPermissions[info.FullMethod]; ok {
+			managerID, err := svc.AuthenticateManager(ctx, token)
+			if err != nil {
+				return nil, mapError(err)
+			}
+			if !svc.HasPermission(ctx, managerID, perm) {
+				return nil, status.Error(codes.PermissionDenied, "manager lacks required permission")
+			}
+			return handler(ctx, req)
+		}
+
+		if _, err := svc.AuthenticateCustomer(ctx, token); err != nil {
+			return nil, mapError(err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+//LoggingInterceptor логирует метод, время выполнения и ошибку каждого вызова.
+func LoggingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		log.Printf("grpc %s took %s, err=%v", info.FullMethod, time.Since(start), err)
+		return resp, err
+	}
+}
@@ -0,0 +1,129 @@
+//Package grpc предоставляет типизированный gRPC-доступ к customers.Service
+//для межсервисных вызовов, в дополнение к HTTP.
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/urunov-fedya/crud/pkg/customers"
+	customerspb "github.com/urunov-fedya/crud/pkg/pb/customers/v1"
+)
+
+//Server реализует customerspb.CustomersServiceServer поверх customers.Service.
+type Server struct {
+	customerspb.UnimplementedCustomersServiceServer
+
+	svc *customers.Service
+}
+
+//NewServer оборачивает customers.Service в gRPC-сервер.
+func NewServer(svc *customers.Service) *Server {
+	return &Server{svc: svc}
+}
+
+func (s *Server) All(ctx context.Context, _ *customerspb.AllRequest) (*customerspb.CustomersResponse, error) {
+	cs, err := s.svc.All(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &customerspb.CustomersResponse{Customers: toProtoCustomers(cs)}, nil
+}
+
+func (s *Server) AllActive(ctx context.Context, _ *customerspb.AllRequest) (*customerspb.CustomersResponse, error) {
+	cs, err := s.svc.AllActive(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &customerspb.CustomersResponse{Customers: toProtoCustomers(cs)}, nil
+}
+
+func (s *Server) ByID(ctx context.Context, req *customerspb.ByIDRequest) (*customerspb.CustomerResponse, error) {
+	c, err := s.svc.ByID(ctx, req.Id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &customerspb.CustomerResponse{Customer: toProtoCustomer(c)}, nil
+}
+
+func (s *Server) ChangeActive(ctx context.Context, req *customerspb.ChangeActiveRequest) (*customerspb.CustomerResponse, error) {
+	c, err := s.svc.ChangeActive(ctx, req.Id, req.Active)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &customerspb.CustomerResponse{Customer: toProtoCustomer(c)}, nil
+}
+
+func (s *Server) Delete(ctx context.Context, req *customerspb.ByIDRequest) (*customerspb.CustomerResponse, error) {
+	c, err := s.svc.Delete(ctx, req.Id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &customerspb.CustomerResponse{Customer: toProtoCustomer(c)}, nil
+}
+
+func (s *Server) Save(ctx context.Context, req *customerspb.SaveRequest) (*customerspb.CustomerResponse, error) {
+	c, err := s.svc.Save(ctx, &customers.Customer{
+		ID:       req.Id,
+		Name:     req.Name,
+		Phone:    req.Phone,
+		Password: req.Password,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &customerspb.CustomerResponse{Customer: toProtoCustomer(c)}, nil
+}
+
+func (s *Server) TokenForCustomer(ctx context.Context, req *customerspb.TokenForCustomerRequest) (*customerspb.TokenResponse, error) {
+	token, err := s.svc.TokenForCustomer(ctx, req.Phone, req.Password)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &customerspb.TokenResponse{Token: token}, nil
+}
+
+func (s *Server) AuthenticateCustomer(ctx context.Context, req *customerspb.AuthenticateCustomerRequest) (*customerspb.AuthenticateCustomerResponse, error) {
+	id, err := s.svc.AuthenticateCustomer(ctx, req.Token)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &customerspb.AuthenticateCustomerResponse{CustomerId: id}, nil
+}
+
+//mapError переводит доменные ошибки customers в коды gRPC.
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, customers.ErrNotFound), errors.Is(err, customers.ErrNoSuchUser):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, customers.ErrInvalidPassword), errors.Is(err, customers.ErrExpireToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return status.Error(codes.Internal, customers.ErrInternal.Error())
+	}
+}
+
+func toProtoCustomer(c *customers.Customer) *customerspb.Customer {
+	if c == nil {
+		return nil
+	}
+	return &customerspb.Customer{
+		Id:      c.ID,
+		Name:    c.Name,
+		Phone:   c.Phone,
+		Active:  c.Active,
+		Created: timestamppb.New(c.Created),
+	}
+}
+
+func toProtoCustomers(cs []*customers.Customer) []*customerspb.Customer {
+	out := make([]*customerspb.Customer, 0, len(cs))
+	for _, c := range cs {
+		out = append(out, toProtoCustomer(c))
+	}
+	return out
+}